@@ -216,11 +216,20 @@ func SerialToString(serial *big.Int) string {
 	return fmt.Sprintf("%036x", serial)
 }
 
+// serialSeparators strips the colon and space separators used when serial
+// numbers are printed by tools such as OpenSSL.
+var serialSeparators = strings.NewReplacer(":", "", " ", "")
+
 // StringToSerial converts a string into a certificate serial number (big.Int)
-// consistently.
+// consistently. Colon or space separated hex, as well as an optional "0x"
+// prefix, are accepted.
 func StringToSerial(serial string) (*big.Int, error) {
 	var serialNum big.Int
-	if !ValidSerial(serial) {
+	serial = serialSeparators.Replace(serial)
+	if strings.HasPrefix(serial, "0x") || strings.HasPrefix(serial, "0X") {
+		serial = serial[2:]
+	}
+	if serial == "" || !ValidSerial(serial) {
 		return &serialNum, errors.New("Invalid serial number")
 	}
 	_, err := fmt.Sscanf(serial, "%036x", &serialNum)
@@ -234,7 +243,7 @@ func ValidSerial(serial string) bool {
 	// Originally, serial numbers were 32 hex characters long. We later increased
 	// them to 36, but we allow the shorter ones because they exist in some
 	// production databases.
-	if len(serial) < 32 && len(serial) > 36 {
+	if len(serial) < 32 || len(serial) > 36 {
 		return false
 	}
 	_, err := hex.DecodeString(serial)
@@ -3,8 +3,10 @@ package core
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"math/big"
+	"os"
 	"reflect"
 	"sort"
 	"testing"
@@ -52,6 +54,40 @@ func TestSerialUtils(t *testing.T) {
 	fmt.Println(badSerial)
 }
 
+func TestStringToSerialFormats(t *testing.T) {
+	expected := big.NewInt(100000000000000000)
+	for _, s := range []string{
+		"00000000000000000000016345785d8a0000",
+		"00:00:00:00:00:00:00:00:00:00:01:63:45:78:5D:8A:00:00",
+		"00 00 00 00 00 00 00 00 00 00 01 63 45 78 5d 8a 00 00",
+		"0x00000000000000000000016345785d8a0000",
+		"0X00000000000000000000016345785D8A0000",
+	} {
+		serialNum, err := StringToSerial(s)
+		test.AssertNotError(t, err, fmt.Sprintf("Couldn't convert serial number %q", s))
+		test.AssertBigIntEquals(t, serialNum, expected)
+	}
+
+	// Digit-only serials are hex, not decimal
+	serialNum, err := StringToSerial("000000000000000000000000000000000100")
+	test.AssertNotError(t, err, "Couldn't convert digit-only serial number")
+	test.AssertBigIntEquals(t, serialNum, big.NewInt(0x100))
+
+	for _, s := range []string{
+		"00:00:zz:00",
+		// 20 byte serials are longer than the 36 hex characters we accept and
+		// must not be truncated
+		"3A:4B:5C:6D:7E:8F:90:A1:B2:C3:D4:E5:F6:07:18:29:3A:4B:5C:6D",
+		"0x01",
+		"0x",
+		":::",
+		"   ",
+	} {
+		_, err = StringToSerial(s)
+		test.AssertEquals(t, fmt.Sprintf("%v", err), "Invalid serial number")
+	}
+}
+
 func TestBuildID(t *testing.T) {
 	test.AssertEquals(t, "Unspecified", GetBuildID())
 }
@@ -145,3 +181,40 @@ func TestProblemDetailsFromError(t *testing.T) {
 	p := ProblemDetailsForError(expected, "k")
 	test.AssertDeepEquals(t, expected, p)
 }
+
+func writeBundle(t *testing.T, files ...string) string {
+	f, err := ioutil.TempFile("", "test-bundle.pem")
+	test.AssertNotError(t, err, "Failed to create temporary bundle file")
+	defer func() { _ = f.Close() }()
+	for _, file := range files {
+		contents, err := ioutil.ReadFile(file)
+		test.AssertNotError(t, err, "Failed to read bundle component")
+		_, err = f.Write(contents)
+		test.AssertNotError(t, err, "Failed to write bundle component")
+	}
+	return f.Name()
+}
+
+func TestLoadCertBundle(t *testing.T) {
+	single, err := LoadCertBundle("../test/test-ca.pem")
+	test.AssertNotError(t, err, "Failed to load single certificate bundle")
+	test.AssertEquals(t, len(single), 1)
+
+	multiFile := writeBundle(t, "../test/test-ca.pem", "../test/test-ca2.pem")
+	defer func() { _ = os.Remove(multiFile) }()
+	multi, err := LoadCertBundle(multiFile)
+	test.AssertNotError(t, err, "Failed to load multiple certificate bundle")
+	test.AssertEquals(t, len(multi), 2)
+	test.AssertByteEquals(t, multi[0].Raw, single[0].Raw)
+	test.Assert(t, !multi[0].Equal(multi[1]), "Bundle certificates returned out of order")
+
+	mixedFile := writeBundle(t, "../test/test-ca.pem", "../test/test-ca.key")
+	defer func() { _ = os.Remove(mixedFile) }()
+	_, err = LoadCertBundle(mixedFile)
+	test.AssertError(t, err, "Loaded bundle containing a non-certificate block")
+
+	emptyFile := writeBundle(t)
+	defer func() { _ = os.Remove(emptyFile) }()
+	_, err = LoadCertBundle(emptyFile)
+	test.AssertError(t, err, "Loaded bundle without any certificates")
+}